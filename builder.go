@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -43,13 +44,15 @@ func (n *NodePattern) render(qb *QueryBuilder) string {
 
 	propStr := ""
 	if len(n.Properties) > 0 {
-		var props []string
-		for key, val := range n.Properties {
+		keys := sortedKeys(n.Properties)
+		props := make([]string, len(keys))
+		for i, key := range keys {
 			paramNum := qb.paramCounter
 			qb.paramCounter++
 			paramName := fmt.Sprintf("p%s_%d", paramSanitizer.ReplaceAllString(key, ""), paramNum)
-			qb.queryParams[paramName] = val
-			props = append(props, fmt.Sprintf("%s: $%s", key, paramName))
+			qb.queryParams[paramName] = n.Properties[key]
+			qb.recordParamKey(paramName, key)
+			props[i] = fmt.Sprintf("%s: $%s", key, paramName)
 		}
 		propStr = fmt.Sprintf(" {%s}", strings.Join(props, ", "))
 	}
@@ -57,30 +60,100 @@ func (n *NodePattern) render(qb *QueryBuilder) string {
 	return fmt.Sprintf("(%s%s%s)", n.Alias, labelStr, propStr)
 }
 
+// sortedKeys returns a map's keys in ascending order, so property rendering
+// and parameter naming are deterministic across runs — a prerequisite for
+// Neo4j's query plan cache and for reproducible tests.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // RelPattern represents a relationship in a Cypher pattern, e.g., -[r:KNOWS {since: 2023}]->.
+// Types holds one or more relationship types rendered as a "|"-joined union
+// (e.g. :KNOWS|FOLLOWS). MinHops/MaxHops describe a variable-length pattern
+// such as *1..3; they only take effect once Hops or AnyHops has been called.
 type RelPattern struct {
 	Alias      string
 	Type       string
+	Types      []string
 	Direction  RelDirection
 	Properties map[string]interface{}
+	MinHops    int
+	MaxHops    *int
+	varLength  bool
+}
+
+// Hops marks the relationship as variable-length with an explicit lower and
+// upper bound, rendering as *min..max (e.g. *1..3), as *n when both bounds
+// are equal, or as *..max when min is 0.
+func (r *RelPattern) Hops(min, max int) *RelPattern {
+	r.MinHops = min
+	r.MaxHops = &max
+	r.varLength = true
+	return r
+}
+
+// AnyHops marks the relationship as variable-length with no bounds,
+// rendering as a bare *.
+func (r *RelPattern) AnyHops() *RelPattern {
+	r.MinHops = 0
+	r.MaxHops = nil
+	r.varLength = true
+	return r
+}
+
+// WithTypes sets a union of relationship types, rendered as :TYPE1|TYPE2.
+func (r *RelPattern) WithTypes(types ...string) *RelPattern {
+	r.Types = types
+	return r
+}
+
+// hopsStr renders the variable-length hop suffix (*, *n, *n.., *..m, or
+// *n..m) for relationships marked with Hops or AnyHops, or "" otherwise.
+func (r *RelPattern) hopsStr() string {
+	if !r.varLength {
+		return ""
+	}
+	switch {
+	case r.MinHops == 0 && r.MaxHops == nil:
+		return "*"
+	case r.MaxHops == nil:
+		return fmt.Sprintf("*%d..", r.MinHops)
+	case r.MinHops == 0:
+		return fmt.Sprintf("*..%d", *r.MaxHops)
+	case r.MinHops == *r.MaxHops:
+		return fmt.Sprintf("*%d", r.MinHops)
+	default:
+		return fmt.Sprintf("*%d..%d", r.MinHops, *r.MaxHops)
+	}
 }
 
 // render converts the RelPattern to its Cypher string representation, including properties.
 func (r *RelPattern) render(qb *QueryBuilder) string {
+	types := r.Types
+	if len(types) == 0 && r.Type != "" {
+		types = []string{r.Type}
+	}
 	relTypeStr := ""
-	if r.Type != "" {
-		relTypeStr = ":" + r.Type
+	if len(types) > 0 {
+		relTypeStr = ":" + strings.Join(types, "|")
 	}
 
 	propStr := ""
 	if len(r.Properties) > 0 {
-		var props []string
-		for key, val := range r.Properties {
+		keys := sortedKeys(r.Properties)
+		props := make([]string, len(keys))
+		for i, key := range keys {
 			paramNum := qb.paramCounter
 			qb.paramCounter++
 			paramName := fmt.Sprintf("p%s_%d", paramSanitizer.ReplaceAllString(key, ""), paramNum)
-			qb.queryParams[paramName] = val
-			props = append(props, fmt.Sprintf("%s: $%s", key, paramName))
+			qb.queryParams[paramName] = r.Properties[key]
+			qb.recordParamKey(paramName, key)
+			props[i] = fmt.Sprintf("%s: $%s", key, paramName)
 		}
 		propStr = fmt.Sprintf(" {%s}", strings.Join(props, ", "))
 	}
@@ -93,7 +166,7 @@ func (r *RelPattern) render(qb *QueryBuilder) string {
 		left = "<-"
 	}
 
-	return fmt.Sprintf("%s[%s%s%s]%s", left, r.Alias, relTypeStr, propStr, right)
+	return fmt.Sprintf("%s[%s%s%s%s]%s", left, r.Alias, relTypeStr, r.hopsStr(), propStr, right)
 }
 
 // --- FLUENT HELPER FUNCTIONS ---
@@ -138,32 +211,250 @@ func (r *RelPattern) From() *RelPattern {
 	return r
 }
 
+// PathPattern wraps a sequence of pattern parts with a named path variable,
+// e.g. p = (a)-[:KNOWS*1..3]->(b), for use with functions like nodes(p),
+// relationships(p), and length(p).
+type PathPattern struct {
+	Alias string
+	Parts []PatternPart
+}
+
+// Path is a shorthand factory function to create a new PathPattern.
+func Path(alias string, parts ...PatternPart) *PathPattern {
+	return &PathPattern{Alias: alias, Parts: parts}
+}
+
+// render converts the PathPattern to its Cypher string representation.
+func (p *PathPattern) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("%s = %s", p.Alias, qb.renderPattern(p.Parts...))
+}
+
 // --- QUERY BUILDER ---
 
 var setParamCounter uint64
 var paramSanitizer = regexp.MustCompile(`[^a-zA-Z0-9]`)
 
+// clause is one stage of the query, in the order it was added. Cypher is
+// order-sensitive (MATCH ... WITH ... MATCH ... RETURN means something
+// different depending on where each clause falls), so QueryBuilder keeps a
+// single ordered list of clauses rather than one slice per clause kind.
+type clause interface {
+	render(qb *QueryBuilder) string
+}
+
+// mergeClause tracks a single MERGE pattern along with the ON CREATE SET / ON
+// MATCH SET assignments attached to it. Those sub-clauses bind to one
+// specific MERGE rather than the query as a whole, so each MERGE keeps its
+// own bookkeeping instead of a flat string.
+type mergeClause struct {
+	pattern  string
+	onCreate []string
+	onMatch  []string
+}
+
+func (m *mergeClause) render(qb *QueryBuilder) string {
+	line := "MERGE " + m.pattern
+	if len(m.onCreate) > 0 {
+		line += " ON CREATE SET " + strings.Join(m.onCreate, ", ")
+	}
+	if len(m.onMatch) > 0 {
+		line += " ON MATCH SET " + strings.Join(m.onMatch, ", ")
+	}
+	return line
+}
+
+// matchClause tracks a single MATCH/OPTIONAL MATCH pattern along with any
+// WHERE predicates attached to it, since Cypher scopes a WHERE to the
+// MATCH it immediately follows rather than to the query as a whole.
+type matchClause struct {
+	optional bool
+	pattern  string
+	where    []Predicate
+}
+
+func (m *matchClause) render(qb *QueryBuilder) string {
+	prefix := "MATCH "
+	if m.optional {
+		prefix = "OPTIONAL MATCH "
+	}
+	line := prefix + m.pattern
+	if len(m.where) > 0 {
+		line += " WHERE " + renderPredicates(qb, m.where)
+	}
+	return line
+}
+
+// createClause renders a single CREATE pattern.
+type createClause struct {
+	pattern string
+}
+
+func (c *createClause) render(qb *QueryBuilder) string {
+	return "CREATE " + c.pattern
+}
+
+// setClause renders one SET clause's worth of assignments.
+type setClause struct {
+	assignments []string
+}
+
+func (s *setClause) render(qb *QueryBuilder) string {
+	return "SET " + strings.Join(s.assignments, ", ")
+}
+
+// deleteClause renders a DELETE or DETACH DELETE clause.
+type deleteClause struct {
+	line string
+}
+
+func (d *deleteClause) render(qb *QueryBuilder) string {
+	return d.line
+}
+
+// withClause renders a WITH projection, optionally DISTINCT and optionally
+// filtered by its own WHERE (via WithWhere), which lets a query re-narrow
+// before a later MATCH.
+type withClause struct {
+	projections []string
+	distinct    bool
+	where       []Predicate
+}
+
+func (w *withClause) render(qb *QueryBuilder) string {
+	prefix := "WITH "
+	if w.distinct {
+		prefix = "WITH DISTINCT "
+	}
+	line := prefix + strings.Join(w.projections, ", ")
+	if len(w.where) > 0 {
+		line += " WHERE " + renderPredicates(qb, w.where)
+	}
+	return line
+}
+
+// unwindClause renders an UNWIND ... AS ... clause.
+type unwindClause struct {
+	expr string
+	as   string
+}
+
+func (u *unwindClause) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("UNWIND %s AS %s", u.expr, u.as)
+}
+
+// callClause renders a CALL procedure(...) [YIELD ...] clause. Arguments are
+// bound as parameters through the same paramCounter scheme as everything
+// else in the builder.
+type callClause struct {
+	procedure string
+	args      []interface{}
+	yields    []string
+}
+
+func (c *callClause) render(qb *QueryBuilder) string {
+	argStrs := make([]string, len(c.args))
+	for i, arg := range c.args {
+		paramNum := qb.paramCounter
+		qb.paramCounter++
+		paramName := fmt.Sprintf("call%d", paramNum)
+		qb.queryParams[paramName] = arg
+		qb.recordParamKey(paramName, fmt.Sprintf("%s.arg%d", c.procedure, i))
+		argStrs[i] = "$" + paramName
+	}
+	line := fmt.Sprintf("CALL %s(%s)", c.procedure, strings.Join(argStrs, ", "))
+	if len(c.yields) > 0 {
+		line += " YIELD " + strings.Join(c.yields, ", ")
+	}
+	return line
+}
+
+// renderPredicates renders a list of predicates as an implicit AND, joining
+// them with " AND " without extra parentheses.
+func renderPredicates(qb *QueryBuilder, preds []Predicate) string {
+	rendered := make([]string, len(preds))
+	for i, p := range preds {
+		rendered[i] = p.render(qb)
+	}
+	return strings.Join(rendered, " AND ")
+}
+
+// OrderItem is a single ORDER BY expression, ascending unless built with Desc.
+type OrderItem struct {
+	Expr string
+	Desc bool
+}
+
+// Asc builds an ascending OrderItem.
+func Asc(expr string) OrderItem {
+	return OrderItem{Expr: expr}
+}
+
+// DescOrder builds a descending OrderItem.
+func DescOrder(expr string) OrderItem {
+	return OrderItem{Expr: expr, Desc: true}
+}
+
 // QueryBuilder is the main entry point for constructing Cypher queries.
 type QueryBuilder struct {
-	matchClauses  []string
-	createClauses []string
-	mergeClauses  []string
-	setClauses    []string
-	deleteClauses []string
-	returnAliases []string
-	queryParams   map[string]interface{}
-	err           error
-	paramCounter  uint64
+	clauses        []clause
+	returnAliases  []string
+	returnDistinct bool
+	orderByItems   []OrderItem
+	skipCount      *int64
+	limitCount     *int64
+	queryParams    map[string]interface{}
+	paramKeys      map[string]string
+	err            error
+	paramCounter   uint64
 }
 
 // NewQueryBuilder creates a new instance of the QueryBuilder.
 func NewQueryBuilder() *QueryBuilder {
 	return &QueryBuilder{
 		queryParams:  make(map[string]interface{}),
+		paramKeys:    make(map[string]string),
 		paramCounter: 0,
 	}
 }
 
+// recordParamKey remembers the caller-facing key (a property name, an
+// assignment target, or a CALL argument index) that produced a generated
+// parameter name, so Prepare/Bind can later re-bind fresh values by that key
+// instead of by the generated name.
+func (qb *QueryBuilder) recordParamKey(paramName, key string) {
+	qb.paramKeys[paramName] = key
+}
+
+// lastMatch returns the most recently added MATCH/OPTIONAL MATCH clause, if
+// the builder's most recent clause is one.
+func (qb *QueryBuilder) lastMatch() (*matchClause, bool) {
+	if len(qb.clauses) == 0 {
+		return nil, false
+	}
+	m, ok := qb.clauses[len(qb.clauses)-1].(*matchClause)
+	return m, ok
+}
+
+// lastMerge returns the most recently added MERGE clause, if the builder's
+// most recent clause is one.
+func (qb *QueryBuilder) lastMerge() (*mergeClause, bool) {
+	if len(qb.clauses) == 0 {
+		return nil, false
+	}
+	m, ok := qb.clauses[len(qb.clauses)-1].(*mergeClause)
+	return m, ok
+}
+
+// lastWith returns the most recently added WITH clause, if the builder's
+// most recent clause is one.
+func (qb *QueryBuilder) lastWith() (*withClause, bool) {
+	if len(qb.clauses) == 0 {
+		return nil, false
+	}
+	w, ok := qb.clauses[len(qb.clauses)-1].(*withClause)
+	return w, ok
+}
+
 // renderPattern is an internal helper that renders a pattern and extracts its parameters.
 func (qb *QueryBuilder) renderPattern(parts ...PatternPart) string {
 	var pattern strings.Builder
@@ -173,7 +464,8 @@ func (qb *QueryBuilder) renderPattern(parts ...PatternPart) string {
 	return pattern.String()
 }
 
-// Match adds a MATCH clause to the query.
+// Match adds a MATCH clause to the query. Chain Where immediately after it
+// to attach a WHERE predicate to this MATCH specifically.
 func (qb *QueryBuilder) Match(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
@@ -182,11 +474,14 @@ func (qb *QueryBuilder) Match(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	qb.matchClauses = append(qb.matchClauses, "MATCH "+patternStr)
+	qb.clauses = append(qb.clauses, &matchClause{pattern: patternStr})
 	return qb
 }
 
-// OptionalMatch adds an OPTIONAL MATCH clause to the query.
+// OptionalMatch adds an OPTIONAL MATCH clause to the query. Chain Where
+// immediately after it to attach a WHERE predicate to this OPTIONAL MATCH
+// specifically; Cypher treats a WHERE on OPTIONAL MATCH as part of the match
+// pattern rather than a post-filter, so it must stay scoped this way.
 func (qb *QueryBuilder) OptionalMatch(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
@@ -195,7 +490,7 @@ func (qb *QueryBuilder) OptionalMatch(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	qb.matchClauses = append(qb.matchClauses, "OPTIONAL MATCH "+patternStr)
+	qb.clauses = append(qb.clauses, &matchClause{optional: true, pattern: patternStr})
 	return qb
 }
 
@@ -208,11 +503,13 @@ func (qb *QueryBuilder) Create(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	qb.createClauses = append(qb.createClauses, "CREATE "+patternStr)
+	qb.clauses = append(qb.clauses, &createClause{pattern: patternStr})
 	return qb
 }
 
-// Merge adds a MERGE clause to the query.
+// Merge adds a MERGE clause to the query. Chain MergeOnCreate and/or
+// MergeOnMatch immediately after it to attach ON CREATE SET / ON MATCH SET
+// sub-clauses to this MERGE.
 func (qb *QueryBuilder) Merge(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
@@ -221,21 +518,140 @@ func (qb *QueryBuilder) Merge(parts ...PatternPart) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	qb.mergeClauses = append(qb.mergeClauses, "MERGE "+patternStr)
+	qb.clauses = append(qb.clauses, &mergeClause{pattern: patternStr})
+	return qb
+}
+
+// With adds a WITH clause projecting the given expressions to later clauses,
+// letting a query re-narrow scope before another MATCH. Chain WithDistinct
+// or WithWhere immediately after it to modify this WITH specifically.
+func (qb *QueryBuilder) With(projections ...string) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	qb.clauses = append(qb.clauses, &withClause{projections: projections})
+	return qb
+}
+
+// WithDistinct marks the most recent WITH clause as WITH DISTINCT.
+func (qb *QueryBuilder) WithDistinct() *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	w, ok := qb.lastWith()
+	if !ok {
+		qb.err = errors.New("WithDistinct: no preceding WITH clause")
+		return qb
+	}
+	w.distinct = true
 	return qb
 }
 
-// Set adds a SET clause to update properties.
+// WithWhere attaches a predicate to the most recent WITH clause.
+func (qb *QueryBuilder) WithWhere(p Predicate) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	w, ok := qb.lastWith()
+	if !ok {
+		qb.err = errors.New("WithWhere: no preceding WITH clause")
+		return qb
+	}
+	w.where = append(w.where, p)
+	return qb
+}
+
+// Unwind adds an UNWIND expr AS as clause, expanding a list expression into
+// one row per element for the rest of the query.
+func (qb *QueryBuilder) Unwind(expr, as string) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	qb.clauses = append(qb.clauses, &unwindClause{expr: expr, as: as})
+	return qb
+}
+
+// Call adds a CALL procedure(args) [YIELD yields] clause, e.g. for built-in
+// procedures like db.labels() or library procedures like apoc.*. Each
+// argument is bound as a parameter.
+func (qb *QueryBuilder) Call(procedure string, args []interface{}, yields []string) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	qb.clauses = append(qb.clauses, &callClause{procedure: procedure, args: args, yields: yields})
+	return qb
+}
+
+// Expr wraps a raw Cypher expression so it is rendered verbatim by Set,
+// MergeOnCreate, and MergeOnMatch instead of being bound as a parameter.
+// Use it for expressions like timestamp() or coalesce(r.times, 0) + 1 that
+// reference the graph itself rather than a caller-supplied value.
+type Expr string
+
+// renderAssignment turns a "prop = value" pair into its rendered Cypher
+// form, allocating a parameter for plain values or emitting an Expr's text
+// verbatim. It backs Set, MergeOnCreate, and MergeOnMatch so all three share
+// the same parameter naming scheme.
+func (qb *QueryBuilder) renderAssignment(prop string, val interface{}) string {
+	if e, ok := val.(Expr); ok {
+		return fmt.Sprintf("%s = %s", prop, string(e))
+	}
+	paramNum := qb.paramCounter
+	qb.paramCounter++
+	paramName := fmt.Sprintf("set%s_%d", paramSanitizer.ReplaceAllString(prop, "_"), paramNum)
+	qb.queryParams[paramName] = val
+	qb.recordParamKey(paramName, prop)
+	return fmt.Sprintf("%s = $%s", prop, paramName)
+}
+
+// Set adds a SET clause to update properties. Values may be plain Go values,
+// which are bound as parameters, or an Expr for raw right-hand sides such as
+// coalesce(u.times, 0) + 1.
 func (qb *QueryBuilder) Set(updates map[string]interface{}) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	for prop, val := range updates {
-		paramNum := qb.paramCounter
-		qb.paramCounter++
-		paramName := fmt.Sprintf("set%s_%d", paramSanitizer.ReplaceAllString(prop, "_"), paramNum)
-		qb.setClauses = append(qb.setClauses, fmt.Sprintf("%s = $%s", prop, paramName))
-		qb.queryParams[paramName] = val
+	keys := sortedKeys(updates)
+	assignments := make([]string, len(keys))
+	for i, prop := range keys {
+		assignments[i] = qb.renderAssignment(prop, updates[prop])
+	}
+	qb.clauses = append(qb.clauses, &setClause{assignments: assignments})
+	return qb
+}
+
+// MergeOnCreate attaches an ON CREATE SET sub-clause to the most recent
+// MERGE. Values may be plain Go values (bound as parameters) or an Expr for
+// raw right-hand sides such as timestamp().
+func (qb *QueryBuilder) MergeOnCreate(updates map[string]interface{}) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	m, ok := qb.lastMerge()
+	if !ok {
+		qb.err = errors.New("MergeOnCreate: no preceding MERGE clause")
+		return qb
+	}
+	for _, prop := range sortedKeys(updates) {
+		m.onCreate = append(m.onCreate, qb.renderAssignment(prop, updates[prop]))
+	}
+	return qb
+}
+
+// MergeOnMatch attaches an ON MATCH SET sub-clause to the most recent MERGE.
+// Values may be plain Go values (bound as parameters) or an Expr for raw
+// right-hand sides such as coalesce(u.times, 0) + 1.
+func (qb *QueryBuilder) MergeOnMatch(updates map[string]interface{}) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	m, ok := qb.lastMerge()
+	if !ok {
+		qb.err = errors.New("MergeOnMatch: no preceding MERGE clause")
+		return qb
+	}
+	for _, prop := range sortedKeys(updates) {
+		m.onMatch = append(m.onMatch, qb.renderAssignment(prop, updates[prop]))
 	}
 	return qb
 }
@@ -245,7 +661,7 @@ func (qb *QueryBuilder) Delete(aliases ...string) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	qb.deleteClauses = append(qb.deleteClauses, "DELETE "+strings.Join(aliases, ", "))
+	qb.clauses = append(qb.clauses, &deleteClause{line: "DELETE " + strings.Join(aliases, ", ")})
 	return qb
 }
 
@@ -254,13 +670,23 @@ func (qb *QueryBuilder) DetachDelete(aliases ...string) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
-	qb.deleteClauses = append(qb.deleteClauses, "DETACH DELETE "+strings.Join(aliases, ", "))
+	qb.clauses = append(qb.clauses, &deleteClause{line: "DETACH DELETE " + strings.Join(aliases, ", ")})
 	return qb
 }
 
-// Where adds a WHERE condition.
-func (qb *QueryBuilder) Where(condition string) *QueryBuilder {
-	// Not implemented for brevity, but would be added here.
+// Where attaches a predicate to the most recent MATCH or OPTIONAL MATCH
+// clause. Build a predicate with Eq, Gt, Lt, In, Contains, StartsWith,
+// IsNull, HasLabel, and the And/Or/Not combinators rather than a raw string.
+func (qb *QueryBuilder) Where(p Predicate) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	m, ok := qb.lastMatch()
+	if !ok {
+		qb.err = errors.New("Where: no preceding MATCH or OPTIONAL MATCH clause")
+		return qb
+	}
+	m.where = append(m.where, p)
 	return qb
 }
 
@@ -270,7 +696,8 @@ func (qb *QueryBuilder) WithParams(params map[string]interface{}) *QueryBuilder
 	return qb
 }
 
-// Return specifies the aliases to be returned by the query.
+// Return specifies the aliases to be returned by the query. Chain Distinct,
+// OrderBy, Skip, and/or Limit to modify the final RETURN.
 func (qb *QueryBuilder) Return(aliases ...string) *QueryBuilder {
 	if qb.err != nil {
 		return qb
@@ -279,39 +706,133 @@ func (qb *QueryBuilder) Return(aliases ...string) *QueryBuilder {
 	return qb
 }
 
-// Build validates and assembles the final query string and the parameter map.
-func (qb *QueryBuilder) Build() (string, map[string]interface{}, error) {
+// Distinct marks the RETURN clause as RETURN DISTINCT.
+func (qb *QueryBuilder) Distinct() *QueryBuilder {
 	if qb.err != nil {
-		return "", nil, qb.err
+		return qb
 	}
-	if len(qb.matchClauses) == 0 && len(qb.createClauses) == 0 && len(qb.mergeClauses) == 0 {
-		return "", nil, errors.New("query must have at least one MATCH, CREATE, or MERGE clause")
+	qb.returnDistinct = true
+	return qb
+}
+
+// OrderBy adds ORDER BY items to the RETURN clause, in the given order.
+func (qb *QueryBuilder) OrderBy(items ...OrderItem) *QueryBuilder {
+	if qb.err != nil {
+		return qb
 	}
+	qb.orderByItems = append(qb.orderByItems, items...)
+	return qb
+}
 
-	var query strings.Builder
+// Skip adds a SKIP modifier to the RETURN clause.
+func (qb *QueryBuilder) Skip(n int64) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	qb.skipCount = &n
+	return qb
+}
 
-	if len(qb.matchClauses) > 0 {
-		query.WriteString(strings.Join(qb.matchClauses, "\n") + "\n")
+// Limit adds a LIMIT modifier to the RETURN clause.
+func (qb *QueryBuilder) Limit(n int64) *QueryBuilder {
+	if qb.err != nil {
+		return qb
 	}
-	if len(qb.mergeClauses) > 0 {
-		query.WriteString(strings.Join(qb.mergeClauses, "\n") + "\n")
+	qb.limitCount = &n
+	return qb
+}
+
+// Build validates and assembles the final query string and the parameter map.
+func (qb *QueryBuilder) Build() (string, map[string]interface{}, error) {
+	if qb.err != nil {
+		return "", nil, qb.err
 	}
-	if len(qb.createClauses) > 0 {
-		query.WriteString(strings.Join(qb.createClauses, "\n") + "\n")
+
+	hasCoreClause := false
+	for _, c := range qb.clauses {
+		switch c.(type) {
+		case *matchClause, *createClause, *mergeClause, *unwindClause, *callClause:
+			hasCoreClause = true
+		}
 	}
-	if len(qb.setClauses) > 0 {
-		query.WriteString("SET " + strings.Join(qb.setClauses, ", ") + "\n")
+	if !hasCoreClause {
+		return "", nil, errors.New("query must have at least one MATCH, CREATE, MERGE, UNWIND, or CALL clause")
 	}
-	if len(qb.deleteClauses) > 0 {
-		query.WriteString(strings.Join(qb.deleteClauses, "\n") + "\n")
+
+	var query strings.Builder
+
+	for _, c := range qb.clauses {
+		query.WriteString(c.render(qb) + "\n")
 	}
+
 	if len(qb.returnAliases) > 0 {
-		query.WriteString("RETURN " + strings.Join(qb.returnAliases, ", "))
+		prefix := "RETURN "
+		if qb.returnDistinct {
+			prefix = "RETURN DISTINCT "
+		}
+		query.WriteString(prefix + strings.Join(qb.returnAliases, ", "))
+		if len(qb.orderByItems) > 0 {
+			items := make([]string, len(qb.orderByItems))
+			for i, item := range qb.orderByItems {
+				items[i] = item.Expr
+				if item.Desc {
+					items[i] += " DESC"
+				}
+			}
+			query.WriteString("\nORDER BY " + strings.Join(items, ", "))
+		}
+		if qb.skipCount != nil {
+			query.WriteString(fmt.Sprintf("\nSKIP %d", *qb.skipCount))
+		}
+		if qb.limitCount != nil {
+			query.WriteString(fmt.Sprintf("\nLIMIT %d", *qb.limitCount))
+		}
 	}
 
 	return strings.TrimSpace(query.String()), qb.queryParams, nil
 }
 
+// PreparedQuery is a Build result cached alongside the property/assignment
+// keys that produced each generated parameter name, so the same rendered
+// Cypher text can be re-executed with fresh values via Bind without paying
+// to re-run the builder — the analog of a prepared statement, which is what
+// Neo4j drivers reward with cached query plans.
+type PreparedQuery struct {
+	query      string
+	paramNames map[string]string // generated param name -> caller-facing key
+}
+
+// Prepare builds the query once and caches the rendered Cypher text plus the
+// generated-parameter-name-to-key mapping needed to re-bind fresh values.
+func (qb *QueryBuilder) Prepare() (*PreparedQuery, error) {
+	query, _, err := qb.Build()
+	if err != nil {
+		return nil, err
+	}
+	paramNames := make(map[string]string, len(qb.paramKeys))
+	for name, key := range qb.paramKeys {
+		paramNames[name] = key
+	}
+	return &PreparedQuery{query: query, paramNames: paramNames}, nil
+}
+
+// Bind pairs a PreparedQuery's cached Cypher text with a fresh set of
+// values, keyed the same way as when the query was built: by property name
+// for node/relationship properties and WHERE predicates (e.g. "name"), by
+// assignment target for Set/MergeOnCreate/MergeOnMatch (e.g. "u.name"), or
+// by "procedure.argN" for CALL arguments.
+func (pq *PreparedQuery) Bind(values map[string]interface{}) (string, map[string]interface{}, error) {
+	params := make(map[string]interface{}, len(pq.paramNames))
+	for paramName, key := range pq.paramNames {
+		val, ok := values[key]
+		if !ok {
+			return "", nil, fmt.Errorf("gocypher: Bind missing value for key %q (param $%s)", key, paramName)
+		}
+		params[paramName] = val
+	}
+	return pq.query, params, nil
+}
+
 func PrintQuery(name string, query string, params map[string]interface{}, err error) {
 	fmt.Printf("--- %s ---\n", name)
 	if err != nil {
@@ -0,0 +1,110 @@
+// Package cyphertest provides a golden-file assertion helper for testing
+// gocypher query builders, so new builder features can be covered with a
+// readable fixture instead of a brittle inline string-equality check.
+package cyphertest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/saulfrancisco-ruizacevedo/gocypher"
+)
+
+// Update, when set via `go test ./... -update`, rewrites golden files with
+// the builder's current output instead of comparing against them.
+var Update = flag.Bool("update", false, "update golden files instead of comparing against them")
+
+var paramRef = regexp.MustCompile(`\$([a-zA-Z0-9_]+)`)
+
+// AssertQuery builds qb and compares its canonicalized output against
+// wantFile. Generated parameter names are unstable across builder calls (they
+// embed a monotonically increasing counter), so both the query text and the
+// param map are canonicalized first: every parameter reference is renamed to
+// $p1, $p2, ... in the order it first appears in the rendered query.
+func AssertQuery(t *testing.T, qb *gocypher.QueryBuilder, wantFile string) {
+	t.Helper()
+
+	query, params, err := qb.Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	got := formatGolden(canonicalize(query, params))
+
+	if *Update {
+		if err := os.WriteFile(wantFile, []byte(got), 0o644); err != nil {
+			t.Fatalf("failed to update golden file %s: %v", wantFile, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(wantFile)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s (run with -update to create it): %v", wantFile, err)
+	}
+	if got != string(want) {
+		t.Errorf("query mismatch for %s\n--- got ---\n%s--- want ---\n%s", wantFile, got, string(want))
+	}
+}
+
+// canonicalize renames every $param reference in query, and its matching key
+// in params, to p1, p2, ... in first-appearance order.
+func canonicalize(query string, params map[string]interface{}) (string, map[string]interface{}) {
+	canonNames := make(map[string]string)
+	var order []string
+
+	canonQuery := paramRef.ReplaceAllStringFunc(query, func(match string) string {
+		name := match[1:]
+		canon, ok := canonNames[name]
+		if !ok {
+			canon = fmt.Sprintf("p%d", len(order)+1)
+			canonNames[name] = canon
+			order = append(order, name)
+		}
+		return "$" + canon
+	})
+
+	canonParams := make(map[string]interface{}, len(params))
+	for name, val := range params {
+		canon, ok := canonNames[name]
+		if !ok {
+			canon = name
+		}
+		canonParams[canon] = val
+	}
+	return canonQuery, canonParams
+}
+
+// formatGolden renders a canonicalized query and its params as golden-file
+// text: the query, then a params section sorted by param index.
+func formatGolden(query string, params map[string]interface{}) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return paramIndex(keys[i]) < paramIndex(keys[j])
+	})
+
+	var b strings.Builder
+	b.WriteString(query)
+	b.WriteString("\n")
+	if len(keys) > 0 {
+		b.WriteString("---PARAMS---\n")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s=%v\n", k, params[k])
+		}
+	}
+	return b.String()
+}
+
+// paramIndex extracts the numeric suffix of a canonicalized "pN" param name.
+func paramIndex(name string) int {
+	n, _ := strconv.Atoi(strings.TrimPrefix(name, "p"))
+	return n
+}
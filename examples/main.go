@@ -21,6 +21,20 @@ func main() {
 		Build()
 	gocypher.PrintQuery("Example 2: Find a node by property", q2, p2, e2)
 
+	// Example 2b: Simple - Prepare a query once, bind fresh values, and re-run it
+	pq, errPrepare := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.N("u", "User").WithProperties(map[string]interface{}{"name": "Alice"}),
+		).
+		Return("u.name", "u.age").
+		Prepare()
+	if errPrepare != nil {
+		gocypher.PrintQuery("Example 2b: Prepare a query", "", nil, errPrepare)
+	} else {
+		q2b, p2b, e2b := pq.Bind(map[string]interface{}{"name": "Bob"})
+		gocypher.PrintQuery("Example 2b: Bind fresh values to a prepared query", q2b, p2b, e2b)
+	}
+
 	// Example 3: Intermediate - Create a related node and a relationship
 	q3, p3, e3 := gocypher.NewQueryBuilder().
 		Match(gocypher.N("u", "User").WithProperties(map[string]interface{}{"name": "Alice"})).
@@ -46,6 +60,21 @@ func main() {
 		Build()
 	gocypher.PrintQuery("Example 4: Merge a node and Set properties (Upsert)", q4, p4, e4)
 
+	// Example 4b: Intermediate - Upsert with ON CREATE / ON MATCH sub-clauses
+	q4b, p4b, e4b := gocypher.NewQueryBuilder().
+		Merge(
+			gocypher.N("u", "User").WithProperties(map[string]interface{}{"id": "user123"}),
+		).
+		MergeOnCreate(map[string]interface{}{
+			"u.created": gocypher.Expr("timestamp()"),
+		}).
+		MergeOnMatch(map[string]interface{}{
+			"u.times": gocypher.Expr("coalesce(u.times, 0) + 1"),
+		}).
+		Return("u").
+		Build()
+	gocypher.PrintQuery("Example 4b: Merge with ON CREATE SET / ON MATCH SET", q4b, p4b, e4b)
+
 	// Example 5: Complex - Find required and optional data with filtering
 	q5, p5, e5 := gocypher.NewQueryBuilder().
 		Match(
@@ -62,6 +91,52 @@ func main() {
 		Build()
 	gocypher.PrintQuery("Example 5: Complex read with Optional Match", q5, p5, e5)
 
+	// Example 5b: Complex - Typed WHERE predicates with And/Or/Not
+	q5b, p5b, e5b := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.N("u", "User"),
+		).
+		Where(gocypher.And(
+			gocypher.Gt("u", "age", 21),
+			gocypher.Or(
+				gocypher.StartsWith("u", "email", "alice"),
+				gocypher.Not(gocypher.IsNull("u", "verifiedAt")),
+			),
+		)).
+		Return("u.name", "u.age").
+		Build()
+	gocypher.PrintQuery("Example 5b: Typed WHERE predicates", q5b, p5b, e5b)
+
+	// Example 5c: Complex - Friend-of-friend reachability via a variable-length path
+	q5c, p5c, e5c := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.Path("p",
+				gocypher.N("u", "User").WithProperties(map[string]interface{}{"name": "Alice"}),
+				gocypher.R("", "KNOWS").WithTypes("KNOWS", "FOLLOWS").Hops(1, 3).To(),
+				gocypher.N("f", "User"),
+			),
+		).
+		Return("nodes(p)", "relationships(p)", "length(p)").
+		Build()
+	gocypher.PrintQuery("Example 5c: Variable-length path reachability", q5c, p5c, e5c)
+
+	// Example 5d: Complex - Multi-stage pipeline with WITH, UNWIND, CALL, ORDER BY, and LIMIT
+	q5d, p5d, e5d := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.N("u", "User"),
+			gocypher.R("", "POSTED").To(),
+			gocypher.N("p", "Post"),
+		).
+		With("u", "count(p) AS postCount").
+		Unwind("[1, 2, 3]", "rank").
+		Call("db.labels", nil, []string{"label"}).
+		Return("u.name", "postCount", "label").
+		OrderBy(gocypher.DescOrder("postCount")).
+		Skip(0).
+		Limit(10).
+		Build()
+	gocypher.PrintQuery("Example 5d: WITH / UNWIND / CALL pipeline", q5d, p5d, e5d)
+
 	// Example 6: Complex - Find and delete a node and its relationships
 	q6, p6, e6 := gocypher.NewQueryBuilder().
 		Match(
@@ -0,0 +1,176 @@
+// Package exec runs gocypher query builders against a real Neo4j database
+// using the official neo4j-go-driver. It is a separate package on purpose:
+// the core gocypher package only ever produces a (query, params, error)
+// triple and never imports the driver, so callers who just want Cypher text
+// generated for them don't compile the driver into their programs.
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/saulfrancisco-ruizacevedo/gocypher"
+)
+
+// cypherRunner is the minimal subset of neo4j.SessionWithContext and
+// neo4j.ManagedTransaction that Runner needs. Extracting it lets Runner work
+// identically whether it is backed by a session or by a transaction handed
+// to it inside RunInTx.
+type cypherRunner interface {
+	Run(ctx context.Context, cypher string, params map[string]interface{}) (neo4j.ResultWithContext, error)
+}
+
+// sessionRunner adapts neo4j.SessionWithContext.Run's variadic transaction
+// configurers away so a session satisfies cypherRunner.
+type sessionRunner struct {
+	session neo4j.SessionWithContext
+}
+
+func (s sessionRunner) Run(ctx context.Context, cypher string, params map[string]interface{}) (neo4j.ResultWithContext, error) {
+	return s.session.Run(ctx, cypher, params)
+}
+
+// Runner executes gocypher query builders against a Neo4j session or, inside
+// RunInTx, a single transaction. session is nil for a Runner scoped to a
+// transaction, since a transaction can't open another transaction.
+type Runner struct {
+	session neo4j.SessionWithContext
+	runner  cypherRunner
+}
+
+// NewRunner wraps a neo4j.SessionWithContext so it can execute QueryBuilder
+// values directly.
+func NewRunner(session neo4j.SessionWithContext) *Runner {
+	return &Runner{session: session, runner: sessionRunner{session: session}}
+}
+
+// Run builds qb and executes it, returning the raw driver result.
+func (r *Runner) Run(ctx context.Context, qb *gocypher.QueryBuilder) (neo4j.ResultWithContext, error) {
+	query, params, err := qb.Build()
+	if err != nil {
+		return nil, err
+	}
+	return r.runner.Run(ctx, query, params)
+}
+
+// Collect builds qb, executes it, and gathers every record into a slice of
+// plain maps keyed by return alias.
+func (r *Runner) Collect(ctx context.Context, qb *gocypher.QueryBuilder) ([]map[string]interface{}, error) {
+	result, err := r.Run(ctx, qb)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	for result.Next(ctx) {
+		record := result.Record()
+		row := make(map[string]interface{}, len(record.Keys))
+		for _, key := range record.Keys {
+			val, _ := record.Get(key)
+			row[key] = val
+		}
+		rows = append(rows, row)
+	}
+	return rows, result.Err()
+}
+
+// CollectInto builds qb, executes it, and scans each record into a new
+// element of dest, which must be a pointer to a slice of structs. Struct
+// fields are matched by a `cypher:"alias.prop"` tag against node and
+// relationship properties in the record.
+func (r *Runner) CollectInto(ctx context.Context, qb *gocypher.QueryBuilder, dest interface{}) error {
+	destPtr := reflect.ValueOf(dest)
+	if destPtr.Kind() != reflect.Ptr || destPtr.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("exec: CollectInto requires a pointer to a slice, got %T", dest)
+	}
+	sliceVal := destPtr.Elem()
+	elemType := sliceVal.Type().Elem()
+
+	result, err := r.Run(ctx, qb)
+	if err != nil {
+		return err
+	}
+
+	for result.Next(ctx) {
+		elem := reflect.New(elemType).Elem()
+		if err := scanRecord(result.Record(), elem); err != nil {
+			return err
+		}
+		sliceVal.Set(reflect.Append(sliceVal, elem))
+	}
+	return result.Err()
+}
+
+// scanRecord fills the exported fields of elem tagged `cypher:"alias.prop"`
+// from the node/relationship properties in record.
+func scanRecord(record *neo4j.Record, elem reflect.Value) error {
+	elemType := elem.Type()
+	for i := 0; i < elemType.NumField(); i++ {
+		field := elemType.Field(i)
+		tag := field.Tag.Get("cypher")
+		if tag == "" {
+			continue
+		}
+		alias, prop, ok := strings.Cut(tag, ".")
+		if !ok {
+			return fmt.Errorf("exec: cypher tag %q on field %s must be \"alias.prop\"", tag, field.Name)
+		}
+		val, err := propertyValue(record, alias, prop)
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			continue
+		}
+		fv := reflect.ValueOf(val)
+		if fv.Type().ConvertibleTo(field.Type) {
+			elem.Field(i).Set(fv.Convert(field.Type))
+		}
+	}
+	return nil
+}
+
+// propertyValue resolves "alias.prop" against the record entry returned
+// under alias: a node or relationship has its properties indexed by prop,
+// and any other value is returned as is, whatever prop is.
+func propertyValue(record *neo4j.Record, alias, prop string) (interface{}, error) {
+	raw, ok := record.Get(alias)
+	if !ok {
+		return nil, fmt.Errorf("exec: record has no value for alias %q", alias)
+	}
+	switch entity := raw.(type) {
+	case neo4j.Node:
+		return entity.Props[prop], nil
+	case neo4j.Relationship:
+		return entity.Props[prop], nil
+	default:
+		return raw, nil
+	}
+}
+
+// RunInTx runs fn inside a Neo4j transaction of the given access mode,
+// letting the driver retry on transient errors the way it does for its own
+// ExecuteRead/ExecuteWrite helpers. It must be called on a Runner created
+// with NewRunner, not one already scoped to a transaction.
+func (r *Runner) RunInTx(ctx context.Context, fn func(*Runner) error, mode neo4j.AccessMode) error {
+	if r.session == nil {
+		return errors.New("exec: RunInTx requires a Runner created with NewRunner")
+	}
+
+	work := func(tx neo4j.ManagedTransaction) (interface{}, error) {
+		return nil, fn(&Runner{runner: tx})
+	}
+
+	var err error
+	switch mode {
+	case neo4j.AccessModeRead:
+		_, err = r.session.ExecuteRead(ctx, work)
+	default:
+		_, err = r.session.ExecuteWrite(ctx, work)
+	}
+	return err
+}
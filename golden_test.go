@@ -0,0 +1,112 @@
+package gocypher_test
+
+import (
+	"testing"
+
+	"github.com/saulfrancisco-ruizacevedo/gocypher"
+	"github.com/saulfrancisco-ruizacevedo/gocypher/cyphertest"
+)
+
+func TestGoldenCreateNode(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Create(gocypher.N("u", "User").WithProperties(map[string]interface{}{"name": "Alice", "age": 30})).
+		Return("u")
+	cyphertest.AssertQuery(t, qb, "testdata/create_node.golden")
+}
+
+func TestGoldenMergeOnCreateOnMatch(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Merge(gocypher.N("u", "User").WithProperties(map[string]interface{}{"id": "user123"})).
+		MergeOnCreate(map[string]interface{}{"u.created": gocypher.Expr("timestamp()")}).
+		MergeOnMatch(map[string]interface{}{"u.times": gocypher.Expr("coalesce(u.times, 0) + 1")}).
+		Return("u")
+	cyphertest.AssertQuery(t, qb, "testdata/merge_on_create_on_match.golden")
+}
+
+func TestGoldenSetMultipleProperties(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Match(gocypher.N("u", "User")).
+		Set(map[string]interface{}{"u.name": "Alice", "u.age": 30, "u.email": "alice@example.com"}).
+		Return("u")
+	cyphertest.AssertQuery(t, qb, "testdata/set_multiple_properties.golden")
+}
+
+func TestGoldenWherePredicates(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Match(gocypher.N("u", "User")).
+		Where(gocypher.And(
+			gocypher.Gt("u", "age", 21),
+			gocypher.Or(
+				gocypher.StartsWith("u", "email", "alice"),
+				gocypher.Not(gocypher.IsNull("u", "verifiedAt")),
+			),
+		)).
+		Return("u.name", "u.age")
+	cyphertest.AssertQuery(t, qb, "testdata/where_predicates.golden")
+}
+
+func TestGoldenVariableLengthPath(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.Path("p",
+				gocypher.N("u", "User").WithProperties(map[string]interface{}{"name": "Alice"}),
+				gocypher.R("", "KNOWS").WithTypes("KNOWS", "FOLLOWS").Hops(1, 3).To(),
+				gocypher.N("f", "User"),
+			),
+		).
+		Return("nodes(p)", "relationships(p)", "length(p)")
+	cyphertest.AssertQuery(t, qb, "testdata/variable_length_path.golden")
+}
+
+func TestGoldenStandaloneUnwind(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Unwind("[1,2,3]", "x").
+		Return("x")
+	cyphertest.AssertQuery(t, qb, "testdata/standalone_unwind.golden")
+}
+
+func TestGoldenStandaloneCall(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Call("db.labels", nil, []string{"label"}).
+		Return("label")
+	cyphertest.AssertQuery(t, qb, "testdata/standalone_call.golden")
+}
+
+func TestGoldenWithDistinct(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Match(gocypher.N("u", "User")).
+		With("u.country").
+		WithDistinct().
+		Return("u.country")
+	cyphertest.AssertQuery(t, qb, "testdata/with_distinct.golden")
+}
+
+func TestGoldenExactHopCount(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.Path("p",
+				gocypher.N("u", "User"),
+				gocypher.R("", "KNOWS").Hops(2, 2).To(),
+				gocypher.N("f", "User"),
+			),
+		).
+		Return("p")
+	cyphertest.AssertQuery(t, qb, "testdata/exact_hop_count.golden")
+}
+
+func TestGoldenPipelineStages(t *testing.T) {
+	qb := gocypher.NewQueryBuilder().
+		Match(
+			gocypher.N("u", "User"),
+			gocypher.R("", "POSTED").To(),
+			gocypher.N("p", "Post"),
+		).
+		With("u", "count(p) AS postCount").
+		Unwind("[1,2,3]", "rank").
+		Call("db.labels", nil, []string{"label"}).
+		Return("u.name", "postCount", "label").
+		OrderBy(gocypher.DescOrder("postCount")).
+		Skip(0).
+		Limit(10)
+	cyphertest.AssertQuery(t, qb, "testdata/pipeline_stages.golden")
+}
@@ -0,0 +1,154 @@
+package gocypher
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Predicate is a composable WHERE condition. Implementations render
+// themselves directly into the QueryBuilder so that every value they carry
+// flows through the same paramCounter/paramSanitizer scheme as the rest of
+// the builder, instead of callers concatenating raw Cypher strings.
+type Predicate interface {
+	render(qb *QueryBuilder) string
+}
+
+// comparisonPredicate renders "alias.prop <op> $param" for a single bound value.
+type comparisonPredicate struct {
+	alias, prop, op string
+	val             interface{}
+}
+
+func (p *comparisonPredicate) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("%s.%s %s $%s", p.alias, p.prop, p.op, qb.bindWhereParam(p.prop, p.val))
+}
+
+// Eq builds an "alias.prop = $param" predicate.
+func Eq(alias, prop string, v interface{}) Predicate {
+	return &comparisonPredicate{alias: alias, prop: prop, op: "=", val: v}
+}
+
+// Gt builds an "alias.prop > $param" predicate.
+func Gt(alias, prop string, v interface{}) Predicate {
+	return &comparisonPredicate{alias: alias, prop: prop, op: ">", val: v}
+}
+
+// Lt builds an "alias.prop < $param" predicate.
+func Lt(alias, prop string, v interface{}) Predicate {
+	return &comparisonPredicate{alias: alias, prop: prop, op: "<", val: v}
+}
+
+// inPredicate renders "alias.prop IN $param", binding the whole slice as one list parameter.
+type inPredicate struct {
+	alias, prop string
+	vals        []interface{}
+}
+
+func (p *inPredicate) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("%s.%s IN $%s", p.alias, p.prop, qb.bindWhereParam(p.prop, p.vals))
+}
+
+// In builds an "alias.prop IN $param" predicate over a list of values.
+func In(alias, prop string, vs []interface{}) Predicate {
+	return &inPredicate{alias: alias, prop: prop, vals: vs}
+}
+
+// stringOpPredicate renders Cypher's string-matching operators (CONTAINS, STARTS WITH).
+type stringOpPredicate struct {
+	alias, prop, op string
+	val             interface{}
+}
+
+func (p *stringOpPredicate) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("%s.%s %s $%s", p.alias, p.prop, p.op, qb.bindWhereParam(p.prop, p.val))
+}
+
+// Contains builds an "alias.prop CONTAINS $param" predicate.
+func Contains(alias, prop string, v interface{}) Predicate {
+	return &stringOpPredicate{alias: alias, prop: prop, op: "CONTAINS", val: v}
+}
+
+// StartsWith builds an "alias.prop STARTS WITH $param" predicate.
+func StartsWith(alias, prop string, v interface{}) Predicate {
+	return &stringOpPredicate{alias: alias, prop: prop, op: "STARTS WITH", val: v}
+}
+
+// isNullPredicate renders "alias.prop IS NULL"; no parameter is needed.
+type isNullPredicate struct {
+	alias, prop string
+}
+
+func (p *isNullPredicate) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("%s.%s IS NULL", p.alias, p.prop)
+}
+
+// IsNull builds an "alias.prop IS NULL" predicate. Combine with Not to get
+// "IS NOT NULL".
+func IsNull(alias, prop string) Predicate {
+	return &isNullPredicate{alias: alias, prop: prop}
+}
+
+// hasLabelPredicate renders "alias:Label".
+type hasLabelPredicate struct {
+	alias, label string
+}
+
+func (p *hasLabelPredicate) render(qb *QueryBuilder) string {
+	return fmt.Sprintf("%s:%s", p.alias, p.label)
+}
+
+// HasLabel builds an "alias:Label" predicate.
+func HasLabel(alias, label string) Predicate {
+	return &hasLabelPredicate{alias: alias, label: label}
+}
+
+// combinatorPredicate joins any number of predicates with AND/OR, wrapping
+// the result in parentheses so it composes safely inside a larger combinator.
+type combinatorPredicate struct {
+	op    string
+	preds []Predicate
+}
+
+func (p *combinatorPredicate) render(qb *QueryBuilder) string {
+	rendered := make([]string, len(p.preds))
+	for i, sub := range p.preds {
+		rendered[i] = sub.render(qb)
+	}
+	return "(" + strings.Join(rendered, " "+p.op+" ") + ")"
+}
+
+// And combines predicates with AND.
+func And(preds ...Predicate) Predicate {
+	return &combinatorPredicate{op: "AND", preds: preds}
+}
+
+// Or combines predicates with OR.
+func Or(preds ...Predicate) Predicate {
+	return &combinatorPredicate{op: "OR", preds: preds}
+}
+
+// notPredicate negates a single predicate.
+type notPredicate struct {
+	pred Predicate
+}
+
+func (p *notPredicate) render(qb *QueryBuilder) string {
+	return "NOT (" + p.pred.render(qb) + ")"
+}
+
+// Not negates a predicate, e.g. Not(IsNull("u", "email")) for "IS NOT NULL".
+func Not(p Predicate) Predicate {
+	return &notPredicate{pred: p}
+}
+
+// bindWhereParam allocates a parameter for a WHERE predicate value, using the
+// same paramCounter/paramSanitizer scheme as Set so parameter names never
+// collide across the query.
+func (qb *QueryBuilder) bindWhereParam(prop string, val interface{}) string {
+	paramNum := qb.paramCounter
+	qb.paramCounter++
+	paramName := fmt.Sprintf("where%s_%d", paramSanitizer.ReplaceAllString(prop, ""), paramNum)
+	qb.queryParams[paramName] = val
+	qb.recordParamKey(paramName, prop)
+	return paramName
+}